@@ -0,0 +1,77 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/ai"
+)
+
+// AIHandler streams a chat completion back to the browser over SSE.
+type AIHandler struct {
+	AI ai.Client
+}
+
+// NewAIHandler builds an AIHandler from its upstream client.
+func NewAIHandler(aiClient ai.Client) *AIHandler {
+	return &AIHandler{AI: aiClient}
+}
+
+type chatRequestBody struct {
+	Messages    []ai.Message `json:"messages" binding:"required,min=1"`
+	Model       string       `json:"model"`
+	Temperature float64      `json:"temperature"`
+}
+
+// Chat handles POST /ai: it streams the model's reply as SSE `token`
+// events, finishing with a `done` event.
+func (h *AIHandler) Chat(c *gin.Context) {
+	var req chatRequestBody
+	if err := c.ShouldBindJSON(&req); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "invalid request: " + err.Error()})
+		return
+	}
+
+	ctx := c.Request.Context()
+	chunks, err := h.AI.StreamChat(ctx, req.Messages, ai.ChatOptions{Model: req.Model, Temperature: req.Temperature})
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	c.Header("Content-Type", "text/event-stream")
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Status(http.StatusOK)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case chunk, ok := <-chunks:
+			if !ok {
+				writeSSE(c.Writer, "done", gin.H{})
+				return
+			}
+			if chunk.Err != nil {
+				writeSSE(c.Writer, "error", gin.H{"error": chunk.Err.Error()})
+				return
+			}
+			writeSSE(c.Writer, "token", gin.H{"content": chunk.Content})
+		}
+	}
+}
+
+// writeSSE writes one Server-Sent Event and flushes it to the client
+// immediately.
+func writeSSE(w gin.ResponseWriter, event string, data any) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	w.Flush()
+}
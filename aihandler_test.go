@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/ai"
+)
+
+// fakeAIClient is a scripted ai.Client for exercising the SSE handler
+// without a real upstream.
+type fakeAIClient struct {
+	chunks []ai.StreamChunk
+}
+
+func (f *fakeAIClient) SuggestEvents(ctx context.Context, transcript string) ([]ai.EventSuggestion, error) {
+	return nil, nil
+}
+
+func (f *fakeAIClient) StreamChat(ctx context.Context, messages []ai.Message, opts ai.ChatOptions) (<-chan ai.StreamChunk, error) {
+	out := make(chan ai.StreamChunk, len(f.chunks))
+	for _, chunk := range f.chunks {
+		out <- chunk
+	}
+	close(out)
+	return out, nil
+}
+
+func TestAIHandlerChatStreamsTokensThenDone(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewAIHandler(&fakeAIClient{chunks: []ai.StreamChunk{
+		{Content: "Hel"},
+		{Content: "lo"},
+	}})
+
+	router := gin.New()
+	router.POST("/ai", handler.Chat)
+
+	req := httptest.NewRequest(http.MethodPost, "/ai", strings.NewReader(`{"messages":[{"role":"user","content":"hi"}]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200 (body: %s)", rec.Code, rec.Body.String())
+	}
+	body := rec.Body.String()
+	if !strings.Contains(body, "event: token\ndata: {\"content\":\"Hel\"}") {
+		t.Errorf("missing first token event: %q", body)
+	}
+	if !strings.Contains(body, "event: token\ndata: {\"content\":\"lo\"}") {
+		t.Errorf("missing second token event: %q", body)
+	}
+	if !strings.Contains(body, "event: done") {
+		t.Errorf("missing done event: %q", body)
+	}
+}
+
+func TestAIHandlerChatRejectsEmptyMessages(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	handler := NewAIHandler(&fakeAIClient{})
+	router := gin.New()
+	router.POST("/ai", handler.Chat)
+
+	req := httptest.NewRequest(http.MethodPost, "/ai", strings.NewReader(`{"messages":[]}`))
+	req.Header.Set("Content-Type", "application/json")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusBadRequest {
+		t.Fatalf("status = %d, want 400 (body: %s)", rec.Code, rec.Body.String())
+	}
+}
@@ -0,0 +1,155 @@
+// Package ai is a small client for an OpenAI-compatible chat completions
+// API (Infomaniak AI Tools, or any compatible endpoint configured via
+// env). It is deliberately upstream-agnostic: only the wire format is
+// assumed, not the provider.
+package ai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL points at Infomaniak's AI Tools gateway.
+const DefaultBaseURL = "https://api.infomaniak.com/1/ai"
+
+// DefaultModel is used when Config.Model is empty.
+const DefaultModel = "mixtral"
+
+// Config configures a Client.
+type Config struct {
+	BaseURL string
+	Model   string
+	APIKey  string
+	Timeout time.Duration
+}
+
+// Message is one turn of a chat conversation.
+type Message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+// Client talks to an OpenAI-compatible chat completions endpoint, either
+// to extract structured event suggestions or to stream a plain chat
+// reply token by token.
+type Client interface {
+	SuggestEvents(ctx context.Context, transcript string) ([]EventSuggestion, error)
+	StreamChat(ctx context.Context, messages []Message, opts ChatOptions) (<-chan StreamChunk, error)
+}
+
+// chatClient is the real, HTTP-backed Client implementation.
+type chatClient struct {
+	cfg        Config
+	httpClient *http.Client
+}
+
+// NewClient builds a Client talking to an OpenAI-compatible chat
+// completions endpoint. A nil httpClient gets a sane default timeout.
+func NewClient(cfg Config, httpClient *http.Client) Client {
+	if cfg.BaseURL == "" {
+		cfg.BaseURL = DefaultBaseURL
+	}
+	cfg.BaseURL = strings.TrimRight(cfg.BaseURL, "/")
+	if cfg.Model == "" {
+		cfg.Model = DefaultModel
+	}
+	if httpClient == nil {
+		timeout := cfg.Timeout
+		if timeout == 0 {
+			timeout = 30 * time.Second
+		}
+		httpClient = &http.Client{Timeout: timeout}
+	}
+	return &chatClient{cfg: cfg, httpClient: httpClient}
+}
+
+const systemPrompt = `You turn email threads into calendar events.
+Read the conversation and decide whether it contains zero or more events worth scheduling (meetings, calls, deadlines, appointments).
+Respond with STRICT JSON ONLY, no prose, no markdown fences, matching this schema:
+[{"title":"string","description":"string","start":"RFC3339 datetime","end":"RFC3339 datetime","location":"string","attendees":["email",...]}]
+If no event is mentioned, respond with [].`
+
+type chatRequest struct {
+	Model       string    `json:"model"`
+	Messages    []Message `json:"messages"`
+	Temperature float64   `json:"temperature"`
+	Stream      bool      `json:"stream"`
+}
+
+type chatResponse struct {
+	Choices []struct {
+		Message Message `json:"message"`
+	} `json:"choices"`
+}
+
+// SuggestEvents sends transcript to the configured model and parses its
+// reply into event suggestions.
+func (c *chatClient) SuggestEvents(ctx context.Context, transcript string) ([]EventSuggestion, error) {
+	reqBody := chatRequest{
+		Model: c.cfg.Model,
+		Messages: []Message{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: transcript},
+		},
+		Temperature: 0.2,
+	}
+
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("ai: encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.BaseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("ai: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ai: chat completion: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("ai: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var chatResp chatResponse
+	if err := json.NewDecoder(resp.Body).Decode(&chatResp); err != nil {
+		return nil, fmt.Errorf("ai: decode response: %w", err)
+	}
+	if len(chatResp.Choices) == 0 {
+		return nil, fmt.Errorf("ai: no choices in response")
+	}
+
+	content := extractJSONArray(chatResp.Choices[0].Message.Content)
+
+	var suggestions []EventSuggestion
+	if err := json.Unmarshal([]byte(content), &suggestions); err != nil {
+		return nil, fmt.Errorf("ai: model did not return valid JSON: %w", err)
+	}
+	return suggestions, nil
+}
+
+// extractJSONArray trims everything before the first '[' and after the
+// matching last ']', in case the model wraps its answer in prose or a
+// markdown code fence despite instructions.
+func extractJSONArray(s string) string {
+	start := strings.Index(s, "[")
+	end := strings.LastIndex(s, "]")
+	if start == -1 || end == -1 || end < start {
+		return s
+	}
+	return s[start : end+1]
+}
@@ -0,0 +1,93 @@
+package ai
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSuggestEvents(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		content    string
+		wantErr    bool
+		wantTitles []string
+	}{
+		{
+			name:       "clean json array",
+			status:     http.StatusOK,
+			content:    `[{"title":"Sync","start":"2026-01-02T10:00:00Z","end":"2026-01-02T10:30:00Z"}]`,
+			wantTitles: []string{"Sync"},
+		},
+		{
+			name:       "no events",
+			status:     http.StatusOK,
+			content:    `[]`,
+			wantTitles: nil,
+		},
+		{
+			name:       "wrapped in prose and code fence",
+			status:     http.StatusOK,
+			content:    "Sure thing!\n```json\n[{\"title\":\"Kickoff\",\"start\":\"2026-01-02T10:00:00Z\",\"end\":\"2026-01-02T11:00:00Z\"}]\n```",
+			wantTitles: []string{"Kickoff"},
+		},
+		{
+			name:    "upstream error",
+			status:  http.StatusInternalServerError,
+			content: `boom`,
+			wantErr: true,
+		},
+		{
+			name:    "garbage content",
+			status:  http.StatusOK,
+			content: `not even close to json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.status)
+				if tt.status != http.StatusOK {
+					w.Write([]byte(tt.content))
+					return
+				}
+				encodedContent, err := json.Marshal(tt.content)
+				if err != nil {
+					t.Fatalf("marshal content: %v", err)
+				}
+				w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":` + string(encodedContent) + `}}]}`))
+			}))
+			defer srv.Close()
+
+			client := NewClient(Config{BaseURL: srv.URL, APIKey: "k"}, nil)
+			got, err := client.SuggestEvents(context.Background(), "hello")
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var titles []string
+			for _, e := range got {
+				titles = append(titles, e.Title)
+			}
+			if len(titles) != len(tt.wantTitles) {
+				t.Fatalf("titles = %v, want %v", titles, tt.wantTitles)
+			}
+			for i := range titles {
+				if titles[i] != tt.wantTitles[i] {
+					t.Errorf("titles[%d] = %q, want %q", i, titles[i], tt.wantTitles[i])
+				}
+			}
+		})
+	}
+}
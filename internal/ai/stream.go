@@ -0,0 +1,138 @@
+package ai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// ChatOptions tweaks a single StreamChat call. A zero value falls back to
+// the client's configured model and a default temperature.
+type ChatOptions struct {
+	Model       string
+	Temperature float64
+}
+
+// StreamChunk is one piece of a streamed chat reply. Err is set, and
+// Content is empty, when the upstream stream breaks mid-flight; the
+// channel is closed right after such a chunk.
+type StreamChunk struct {
+	Content string
+	Err     error
+}
+
+const streamDoneMarker = "[DONE]"
+
+// sseDelta mirrors the OpenAI-compatible streaming chat completion chunk
+// shape: {"choices":[{"delta":{"content":"..."}}]}.
+type sseDelta struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+	} `json:"choices"`
+}
+
+// StreamChat opens a streaming chat completion and emits each delta's
+// content on the returned channel as it arrives. The channel is closed
+// when the upstream stream ends or ctx is cancelled.
+func (c *chatClient) StreamChat(ctx context.Context, messages []Message, opts ChatOptions) (<-chan StreamChunk, error) {
+	model := opts.Model
+	if model == "" {
+		model = c.cfg.Model
+	}
+
+	reqBody := chatRequest{
+		Model:       model,
+		Messages:    messages,
+		Temperature: opts.Temperature,
+		Stream:      true,
+	}
+	payload, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("ai: encode request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.cfg.BaseURL+"/chat/completions", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("ai: build request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	if c.cfg.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.cfg.APIKey)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ai: chat completion: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("ai: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	chunks := make(chan StreamChunk)
+	go streamSSE(ctx, resp.Body, chunks)
+	return chunks, nil
+}
+
+// streamSSE reads Server-Sent Events `data:` lines from body, forwarding
+// each delta's content to out. It closes body and out before returning.
+// Every send also selects on ctx.Done(), so a caller that stops reading
+// out after ctx is cancelled (e.g. the client disconnected) never leaves
+// this goroutine parked forever on a full channel.
+func streamSSE(ctx context.Context, body io.ReadCloser, out chan<- StreamChunk) {
+	defer close(out)
+	defer body.Close()
+
+	send := func(chunk StreamChunk) bool {
+		select {
+		case out <- chunk:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		data, ok := strings.CutPrefix(line, "data:")
+		if !ok {
+			continue
+		}
+		data = strings.TrimSpace(data)
+		if data == streamDoneMarker {
+			return
+		}
+		if data == "" {
+			continue
+		}
+
+		var delta sseDelta
+		if err := json.Unmarshal([]byte(data), &delta); err != nil {
+			send(StreamChunk{Err: fmt.Errorf("ai: decode stream chunk: %w", err)})
+			return
+		}
+		if len(delta.Choices) == 0 {
+			continue
+		}
+		if content := delta.Choices[0].Delta.Content; content != "" {
+			if !send(StreamChunk{Content: content}) {
+				return
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		send(StreamChunk{Err: fmt.Errorf("ai: read stream: %w", err)})
+	}
+}
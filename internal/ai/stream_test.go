@@ -0,0 +1,141 @@
+package ai
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func sseServer(t *testing.T, lines []string, delay time.Duration) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			t.Fatal("response writer does not support flushing")
+		}
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		for _, line := range lines {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(delay):
+			}
+			w.Write([]byte("data: " + line + "\n\n"))
+			flusher.Flush()
+		}
+	}))
+}
+
+func TestStreamChatDeliversDeltasInOrder(t *testing.T) {
+	srv := sseServer(t, []string{
+		`{"choices":[{"delta":{"content":"Hel"}}]}`,
+		`{"choices":[{"delta":{"content":"lo"}}]}`,
+		`[DONE]`,
+	}, 0)
+	defer srv.Close()
+
+	client := NewClient(Config{BaseURL: srv.URL, APIKey: "k"}, nil)
+	chunks, err := client.StreamChat(context.Background(), []Message{{Role: "user", Content: "hi"}}, ChatOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var got strings.Builder
+	for chunk := range chunks {
+		if chunk.Err != nil {
+			t.Fatalf("unexpected chunk error: %v", chunk.Err)
+		}
+		got.WriteString(chunk.Content)
+	}
+	if got.String() != "Hello" {
+		t.Errorf("got %q, want %q", got.String(), "Hello")
+	}
+}
+
+func TestStreamChatStopsOnContextCancel(t *testing.T) {
+	srv := sseServer(t, []string{
+		`{"choices":[{"delta":{"content":"a"}}]}`,
+		`{"choices":[{"delta":{"content":"b"}}]}`,
+		`{"choices":[{"delta":{"content":"c"}}]}`,
+		`[DONE]`,
+	}, 20*time.Millisecond)
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := NewClient(Config{BaseURL: srv.URL}, nil)
+	chunks, err := client.StreamChat(ctx, []Message{{Role: "user", Content: "hi"}}, ChatOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	<-chunks // first chunk
+	cancel()
+
+	timeout := time.After(2 * time.Second)
+	for {
+		select {
+		case _, ok := <-chunks:
+			if !ok {
+				return // channel closed promptly after cancellation, as expected
+			}
+		case <-timeout:
+			t.Fatal("stream did not close after context cancellation")
+		}
+	}
+}
+
+// TestStreamChatDoesNotLeakWhenCallerStopsReading mimics AIHandler.Chat:
+// it reads one chunk, cancels ctx, then stops reading entirely (as the
+// handler does once its own select sees ctx.Done()). The streamSSE
+// goroutine must still exit instead of parking forever on a blocked
+// send to the now-abandoned channel.
+func TestStreamChatDoesNotLeakWhenCallerStopsReading(t *testing.T) {
+	srv := sseServer(t, []string{
+		`{"choices":[{"delta":{"content":"a"}}]}`,
+		`{"choices":[{"delta":{"content":"b"}}]}`,
+		`{"choices":[{"delta":{"content":"c"}}]}`,
+		`[DONE]`,
+	}, 10*time.Millisecond)
+	defer srv.Close()
+
+	before := runtime.NumGoroutine()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	client := NewClient(Config{BaseURL: srv.URL}, nil)
+	chunks, err := client.StreamChat(ctx, []Message{{Role: "user", Content: "hi"}}, ChatOptions{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	<-chunks // first chunk, like the handler's select loop
+	cancel() // simulate the client disconnecting; the handler stops reading here
+
+	deadline := time.Now().Add(2 * time.Second)
+	for time.Now().Before(deadline) {
+		runtime.Gosched()
+		time.Sleep(10 * time.Millisecond)
+		if runtime.NumGoroutine() <= before {
+			return // the streamSSE goroutine exited, as expected
+		}
+	}
+	t.Fatalf("streamSSE goroutine leaked: goroutines before=%d after=%d", before, runtime.NumGoroutine())
+}
+
+func TestStreamChatUpstreamError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		w.Write([]byte("upstream down"))
+	}))
+	defer srv.Close()
+
+	client := NewClient(Config{BaseURL: srv.URL}, nil)
+	_, err := client.StreamChat(context.Background(), []Message{{Role: "user", Content: "hi"}}, ChatOptions{})
+	if err == nil {
+		t.Fatal("expected error, got none")
+	}
+}
@@ -0,0 +1,13 @@
+package ai
+
+// EventSuggestion is one calendar event the LLM extracted from a mail
+// thread. Start/End are kept as the raw strings the model returned
+// (expected RFC 3339) so callers decide how strictly to parse them.
+type EventSuggestion struct {
+	Title       string   `json:"title"`
+	Description string   `json:"description"`
+	Start       string   `json:"start"`
+	End         string   `json:"end"`
+	Location    string   `json:"location"`
+	Attendees   []string `json:"attendees"`
+}
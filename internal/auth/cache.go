@@ -0,0 +1,88 @@
+package auth
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/infomaniak"
+)
+
+// profileCache is a small in-memory LRU cache of introspected profiles,
+// keyed by the SHA-256 of the token so raw tokens never sit in memory
+// longer than the call that produced them.
+type profileCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	ll       *list.List
+	items    map[string]*list.Element
+}
+
+type profileCacheEntry struct {
+	key       string
+	profile   *infomaniak.Profile
+	expiresAt time.Time
+}
+
+// newProfileCache builds a cache holding at most capacity entries, each
+// valid for ttl.
+func newProfileCache(capacity int, ttl time.Duration) *profileCache {
+	return &profileCache{
+		capacity: capacity,
+		ttl:      ttl,
+		ll:       list.New(),
+		items:    make(map[string]*list.Element),
+	}
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func (c *profileCache) get(token string) (*infomaniak.Profile, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := hashToken(token)
+	el, ok := c.items[key]
+	if !ok {
+		return nil, false
+	}
+	entry := el.Value.(*profileCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		c.ll.Remove(el)
+		delete(c.items, key)
+		return nil, false
+	}
+	c.ll.MoveToFront(el)
+	return entry.profile, true
+}
+
+func (c *profileCache) set(token string, profile *infomaniak.Profile) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := hashToken(token)
+	if el, ok := c.items[key]; ok {
+		entry := el.Value.(*profileCacheEntry)
+		entry.profile = profile
+		entry.expiresAt = time.Now().Add(c.ttl)
+		c.ll.MoveToFront(el)
+		return
+	}
+
+	el := c.ll.PushFront(&profileCacheEntry{key: key, profile: profile, expiresAt: time.Now().Add(c.ttl)})
+	c.items[key] = el
+
+	if c.ll.Len() > c.capacity {
+		oldest := c.ll.Back()
+		if oldest != nil {
+			c.ll.Remove(oldest)
+			delete(c.items, oldest.Value.(*profileCacheEntry).key)
+		}
+	}
+}
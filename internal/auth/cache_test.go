@@ -0,0 +1,41 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/infomaniak"
+)
+
+func TestProfileCacheGetSetAndExpiry(t *testing.T) {
+	c := newProfileCache(2, 10*time.Millisecond)
+	p := &infomaniak.Profile{ID: "u1"}
+
+	c.set("tok", p)
+	if got, ok := c.get("tok"); !ok || got.ID != "u1" {
+		t.Fatalf("expected cached profile, got %v, %v", got, ok)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if _, ok := c.get("tok"); ok {
+		t.Error("expected entry to have expired")
+	}
+}
+
+func TestProfileCacheEvictsLeastRecentlyUsed(t *testing.T) {
+	c := newProfileCache(2, time.Minute)
+	c.set("a", &infomaniak.Profile{ID: "a"})
+	c.set("b", &infomaniak.Profile{ID: "b"})
+	c.get("a") // touch a, so b becomes the LRU entry
+	c.set("c", &infomaniak.Profile{ID: "c"})
+
+	if _, ok := c.get("b"); ok {
+		t.Error("expected b to have been evicted as least recently used")
+	}
+	if _, ok := c.get("a"); !ok {
+		t.Error("expected a to still be cached")
+	}
+	if _, ok := c.get("c"); !ok {
+		t.Error("expected c to be cached")
+	}
+}
@@ -0,0 +1,51 @@
+package auth
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/infomaniak"
+)
+
+// MailboxVerifier checks that the caller's token actually owns the
+// `:mailbox_uuid` a route is scoped to, caching introspection results so
+// repeat calls don't all hit Infomaniak's /profile endpoint.
+type MailboxVerifier struct {
+	client *infomaniak.Client
+	cache  *profileCache
+}
+
+// NewMailboxVerifier builds a MailboxVerifier backed by client, caching
+// up to cacheSize profiles for ttl each.
+func NewMailboxVerifier(client *infomaniak.Client, cacheSize int, ttl time.Duration) *MailboxVerifier {
+	return &MailboxVerifier{client: client, cache: newProfileCache(cacheSize, ttl)}
+}
+
+// VerifyMailboxOwner is Gin middleware for routes with a `:mailbox_uuid`
+// param: it 403s if the authenticated caller (see Middleware) doesn't
+// own that mailbox.
+func (v *MailboxVerifier) VerifyMailboxOwner() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := TokenFrom(c)
+		mailboxUUID := c.Param("mailbox_uuid")
+
+		profile, ok := v.cache.get(token)
+		if !ok {
+			fetched, err := v.client.FetchProfile(c.Request.Context(), token)
+			if err != nil {
+				c.AbortWithStatusJSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+				return
+			}
+			profile = fetched
+			v.cache.set(token, profile)
+		}
+
+		if !profile.OwnsMailbox(mailboxUUID) {
+			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "token does not authorize this mailbox"})
+			return
+		}
+		c.Next()
+	}
+}
@@ -0,0 +1,62 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/infomaniak"
+)
+
+func TestVerifyMailboxOwner(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var profileCalls int
+	profileSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		profileCalls++
+		w.Write([]byte(`{"result":"success","data":{"id":"u1","mailbox_uuids":["mbx-1"]}}`))
+	}))
+	defer profileSrv.Close()
+
+	client := infomaniak.NewClient(profileSrv.URL, nil)
+	verifier := NewMailboxVerifier(client, 16, time.Minute)
+
+	router := gin.New()
+	router.Use(Middleware())
+	router.GET("/mail/:mailbox_uuid", verifier.VerifyMailboxOwner(), func(c *gin.Context) {
+		c.Status(http.StatusOK)
+	})
+
+	authed := func() *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/mail/mbx-1", nil)
+		req.Header.Set("Authorization", "Bearer tok")
+		return req
+	}
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, authed())
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+
+	// Second call for the same token should hit the cache, not Infomaniak.
+	rec2 := httptest.NewRecorder()
+	router.ServeHTTP(rec2, authed())
+	if rec2.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec2.Code)
+	}
+	if profileCalls != 1 {
+		t.Errorf("profile fetched %d times, want 1 (cache should have served the second call)", profileCalls)
+	}
+
+	forbidden := httptest.NewRequest(http.MethodGet, "/mail/mbx-2", nil)
+	forbidden.Header.Set("Authorization", "Bearer tok")
+	rec3 := httptest.NewRecorder()
+	router.ServeHTTP(rec3, forbidden)
+	if rec3.Code != http.StatusForbidden {
+		t.Fatalf("status = %d, want 403 for a mailbox the token doesn't own", rec3.Code)
+	}
+}
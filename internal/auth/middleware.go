@@ -0,0 +1,60 @@
+// Package auth extracts and, optionally, verifies the caller's identity
+// for mailbox-scoped routes.
+package auth
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// sessionCookieName is the fallback carrier for browser callers that
+// can't set an Authorization header directly.
+const sessionCookieName = "sess"
+
+// tokenContextKey is the Gin context key the bearer token is stored
+// under.
+const tokenContextKey = "auth_token"
+
+const bearerPrefix = "Bearer "
+
+// Middleware extracts the caller's token from an `Authorization: Bearer`
+// header, falling back to a `sess` cookie for browser callers. Requests
+// without either are rejected with 401.
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		token := extractToken(c)
+		if token == "" {
+			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "missing bearer token"})
+			return
+		}
+		c.Set(tokenContextKey, token)
+		c.Next()
+	}
+}
+
+// TokenFrom returns the token Middleware stashed on c, or "" if
+// Middleware wasn't installed on this route.
+func TokenFrom(c *gin.Context) string {
+	v, _ := c.Get(tokenContextKey)
+	token, _ := v.(string)
+	return token
+}
+
+func extractToken(c *gin.Context) string {
+	if token := bearerToken(c.GetHeader("Authorization")); token != "" {
+		return token
+	}
+	if cookie, err := c.Cookie(sessionCookieName); err == nil && cookie != "" {
+		return cookie
+	}
+	return ""
+}
+
+func bearerToken(header string) string {
+	if !strings.HasPrefix(header, bearerPrefix) {
+		return ""
+	}
+	return strings.TrimSpace(strings.TrimPrefix(header, bearerPrefix))
+}
@@ -0,0 +1,54 @@
+package auth
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tests := []struct {
+		name       string
+		authHeader string
+		cookie     string
+		wantStatus int
+		wantToken  string
+	}{
+		{"bearer header", "Bearer abc123", "", http.StatusOK, "abc123"},
+		{"sess cookie fallback", "", "cookie-token", http.StatusOK, "cookie-token"},
+		{"bearer wins over cookie", "Bearer abc123", "cookie-token", http.StatusOK, "abc123"},
+		{"missing both", "", "", http.StatusUnauthorized, ""},
+		{"malformed header", "Token abc123", "", http.StatusUnauthorized, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			router := gin.New()
+			router.Use(Middleware())
+			router.GET("/x", func(c *gin.Context) {
+				c.String(http.StatusOK, TokenFrom(c))
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "/x", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: sessionCookieName, Value: tt.cookie})
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if tt.wantStatus == http.StatusOK && rec.Body.String() != tt.wantToken {
+				t.Errorf("token = %q, want %q", rec.Body.String(), tt.wantToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,211 @@
+// Package config loads and validates this service's configuration from a
+// layered set of env sources: checked-in defaults, a gitignored local
+// override file, then the real process environment.
+package config
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// HTTP holds the bind address and server timeouts.
+//
+// There is deliberately no blanket write timeout: net/http's
+// http.Server.WriteTimeout bounds the entire response write with no
+// exception for streaming handlers, so it would truncate long /ai chat
+// streams and slow mail-thread round trips. Those are instead bounded
+// by AI.Timeout and Infomaniak.Timeout on the upstream calls that back
+// them.
+type HTTP struct {
+	Host              string
+	Port              string
+	ReadTimeout       time.Duration
+	ReadHeaderTimeout time.Duration
+	IdleTimeout       time.Duration
+	ShutdownTimeout   time.Duration
+}
+
+// Addr returns the "host:port" address to bind.
+func (h HTTP) Addr() string {
+	return h.Host + ":" + h.Port
+}
+
+// AI configures the LLM client used for event suggestions and chat.
+type AI struct {
+	BaseURL string
+	Model   string
+	APIKey  string
+	Timeout time.Duration
+}
+
+// Infomaniak configures the Infomaniak Mail API client.
+type Infomaniak struct {
+	BaseURL string
+	Timeout time.Duration
+}
+
+// Log configures the structured logger.
+type Log struct {
+	Level  string
+	Format string
+}
+
+// CORS configures the allowed cross-origin callers.
+type CORS struct {
+	AllowedOrigins []string
+}
+
+// Auth configures bearer-token introspection against Infomaniak's
+// /profile endpoint.
+type Auth struct {
+	// IntrospectionEnabled gates the /profile lookup that validates a
+	// token actually owns the mailbox it's calling into.
+	IntrospectionEnabled   bool
+	IntrospectionCacheSize int
+	IntrospectionCacheTTL  time.Duration
+}
+
+// Config is the service's full, validated configuration.
+type Config struct {
+	// Mode is "debug" or "release", controlling Gin's mode.
+	Mode       string
+	HTTP       HTTP
+	AI         AI
+	Infomaniak Infomaniak
+	Log        Log
+	CORS       CORS
+	Auth       Auth
+}
+
+// requiredKeys lists the env vars Load refuses to start without.
+var requiredKeys = []string{"AI_API_KEY"}
+
+// Load reads, in increasing priority, ".env.default" (checked-in
+// defaults), ".env" (gitignored local overrides), then the real process
+// environment, and decodes the result into a Config. Missing files at
+// either layer are not an error; missing required keys are.
+func Load() (*Config, error) {
+	env, err := layeredEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	var missing []string
+	for _, key := range requiredKeys {
+		if env[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return nil, &MissingFieldsError{Fields: missing}
+	}
+
+	return &Config{
+		Mode: getDefault(env, "GIN_MODE", "debug"),
+		HTTP: HTTP{
+			Host:              getDefault(env, "HTTP_HOST", "localhost"),
+			Port:              getDefault(env, "HTTP_PORT", "8080"),
+			ReadTimeout:       getDuration(env, "HTTP_READ_TIMEOUT", 15*time.Second),
+			ReadHeaderTimeout: getDuration(env, "HTTP_READ_HEADER_TIMEOUT", 5*time.Second),
+			IdleTimeout:       getDuration(env, "HTTP_IDLE_TIMEOUT", 60*time.Second),
+			ShutdownTimeout:   getDuration(env, "HTTP_SHUTDOWN_TIMEOUT", 30*time.Second),
+		},
+		AI: AI{
+			BaseURL: getDefault(env, "AI_BASE_URL", "https://api.infomaniak.com/1/ai"),
+			Model:   getDefault(env, "AI_MODEL", "mixtral"),
+			APIKey:  env["AI_API_KEY"],
+			Timeout: getDuration(env, "AI_TIMEOUT", 30*time.Second),
+		},
+		Infomaniak: Infomaniak{
+			BaseURL: getDefault(env, "INFOMANIAK_BASE_URL", "https://api.infomaniak.com"),
+			Timeout: getDuration(env, "INFOMANIAK_TIMEOUT", 15*time.Second),
+		},
+		Log: Log{
+			Level:  getDefault(env, "LOG_LEVEL", "info"),
+			Format: getDefault(env, "LOG_FORMAT", "json"),
+		},
+		CORS: CORS{
+			AllowedOrigins: getCSV(env, "CORS_ALLOWED_ORIGINS"),
+		},
+		Auth: Auth{
+			IntrospectionEnabled:   getBool(env, "AUTH_INTROSPECTION_ENABLED", false),
+			IntrospectionCacheSize: getInt(env, "AUTH_INTROSPECTION_CACHE_SIZE", 1024),
+			IntrospectionCacheTTL:  getDuration(env, "AUTH_INTROSPECTION_CACHE_TTL", 5*time.Minute),
+		},
+	}, nil
+}
+
+// Redacted returns a copy of c with secrets replaced by a fixed
+// placeholder, safe to log or print.
+func (c Config) Redacted() Config {
+	if c.AI.APIKey != "" {
+		c.AI.APIKey = "***redacted***"
+	}
+	return c
+}
+
+func getDefault(env map[string]string, key, def string) string {
+	if v, ok := env[key]; ok && v != "" {
+		return v
+	}
+	return def
+}
+
+func getDuration(env map[string]string, key string, def time.Duration) time.Duration {
+	raw, ok := env[key]
+	if !ok || raw == "" {
+		return def
+	}
+	d, err := time.ParseDuration(raw)
+	if err != nil {
+		return def
+	}
+	return d
+}
+
+func getBool(env map[string]string, key string, def bool) bool {
+	raw, ok := env[key]
+	if !ok || raw == "" {
+		return def
+	}
+	b, err := strconv.ParseBool(raw)
+	if err != nil {
+		return def
+	}
+	return b
+}
+
+func getInt(env map[string]string, key string, def int) int {
+	raw, ok := env[key]
+	if !ok || raw == "" {
+		return def
+	}
+	n, err := strconv.Atoi(raw)
+	if err != nil {
+		return def
+	}
+	return n
+}
+
+func getCSV(env map[string]string, key string) []string {
+	raw := strings.Split(env[key], ",")
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// MissingFieldsError reports every required env var missing at once,
+// rather than failing on the first one found.
+type MissingFieldsError struct {
+	Fields []string
+}
+
+func (e *MissingFieldsError) Error() string {
+	return fmt.Sprintf("config: missing required environment variable(s): %s", strings.Join(e.Fields, ", "))
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// withWorkdir chdirs to a fresh temp directory for the duration of the
+// test, so Load's relative ".env"/".env.default" lookups are isolated.
+func withWorkdir(t *testing.T) string {
+	t.Helper()
+	dir := t.TempDir()
+	prev, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(prev) })
+	return dir
+}
+
+func writeFile(t *testing.T, dir, name, content string) {
+	t.Helper()
+	if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoadMissingRequiredFieldsReportsAllAtOnce(t *testing.T) {
+	withWorkdir(t)
+	t.Setenv("AI_API_KEY", "")
+
+	_, err := Load()
+	if err == nil {
+		t.Fatal("expected an error")
+	}
+	var missingErr *MissingFieldsError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("expected *MissingFieldsError, got %T: %v", err, err)
+	}
+	if len(missingErr.Fields) != 1 || missingErr.Fields[0] != "AI_API_KEY" {
+		t.Errorf("missing fields = %v, want [AI_API_KEY]", missingErr.Fields)
+	}
+}
+
+func TestLoadLayersDefaultsThenDotenvThenRealEnv(t *testing.T) {
+	dir := withWorkdir(t)
+	writeFile(t, dir, ".env.default", "AI_MODEL=default-model\nHTTP_PORT=9000\n")
+	writeFile(t, dir, ".env", "AI_MODEL=local-model\n")
+	t.Setenv("AI_API_KEY", "real-key")
+	t.Setenv("HTTP_PORT", "7000")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if cfg.AI.Model != "local-model" {
+		t.Errorf("AI.Model = %q, want .env to win over .env.default", cfg.AI.Model)
+	}
+	if cfg.HTTP.Port != "7000" {
+		t.Errorf("HTTP.Port = %q, want real env to win over both dotenv files", cfg.HTTP.Port)
+	}
+}
+
+func TestLoadAppliesDefaultsAndParsesDurations(t *testing.T) {
+	withWorkdir(t)
+	t.Setenv("AI_API_KEY", "k")
+	t.Setenv("AI_TIMEOUT", "5s")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if cfg.HTTP.Host != "localhost" {
+		t.Errorf("HTTP.Host = %q, want default localhost", cfg.HTTP.Host)
+	}
+	if cfg.AI.Timeout != 5*time.Second {
+		t.Errorf("AI.Timeout = %v, want 5s", cfg.AI.Timeout)
+	}
+	if cfg.HTTP.Addr() != "localhost:8080" {
+		t.Errorf("HTTP.Addr() = %q, want localhost:8080", cfg.HTTP.Addr())
+	}
+}
+
+func TestConfigRedactedHidesAPIKey(t *testing.T) {
+	cfg := Config{AI: AI{APIKey: "super-secret"}}
+	redacted := cfg.Redacted()
+
+	if redacted.AI.APIKey == "super-secret" {
+		t.Error("Redacted() leaked the real API key")
+	}
+	if cfg.AI.APIKey != "super-secret" {
+		t.Error("Redacted() mutated the original Config")
+	}
+}
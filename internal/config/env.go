@@ -0,0 +1,37 @@
+package config
+
+import (
+	"os"
+	"strings"
+
+	"github.com/joho/godotenv"
+)
+
+// layeredEnv merges, from lowest to highest priority, ".env.default",
+// ".env", and the real process environment. Missing files at either
+// dotenv layer are ignored: both are optional.
+func layeredEnv() (map[string]string, error) {
+	merged := map[string]string{}
+
+	for _, path := range []string{".env.default", ".env"} {
+		values, err := godotenv.Read(path)
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+		for k, v := range values {
+			merged[k] = v
+		}
+	}
+
+	for _, kv := range os.Environ() {
+		k, v, ok := strings.Cut(kv, "=")
+		if ok {
+			merged[k] = v
+		}
+	}
+
+	return merged, nil
+}
@@ -0,0 +1,75 @@
+package httpx
+
+import (
+	"log/slog"
+	"os"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// NewLogger builds a JSON slog.Logger writing to stdout at the given
+// level (debug/info/warn/error; anything else falls back to info). The
+// default "time" key is renamed to "ts" to match this service's log
+// schema.
+func NewLogger(level string) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level: parseLevel(level),
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				a.Key = "ts"
+			}
+			return a
+		},
+	}
+	return slog.New(slog.NewJSONHandler(os.Stdout, opts))
+}
+
+func parseLevel(level string) slog.Level {
+	switch level {
+	case "debug":
+		return slog.LevelDebug
+	case "warn":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// Logger emits one JSON line per request via logger, once the request
+// has been fully handled.
+func Logger(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+
+		c.Next()
+
+		latency := time.Since(start)
+		status := c.Writer.Status()
+
+		level := slog.LevelInfo
+		if status >= http500Threshold {
+			level = slog.LevelError
+		} else if status >= http400Threshold {
+			level = slog.LevelWarn
+		}
+
+		logger.LogAttrs(c.Request.Context(), level, "http_request",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(latency.Microseconds())/1000),
+			slog.String("client_ip", c.ClientIP()),
+			slog.String("user_agent", c.Request.UserAgent()),
+			slog.String("request_id", RequestIDFrom(c)),
+			slog.String("error", c.Errors.String()),
+		)
+	}
+}
+
+const (
+	http400Threshold = 400
+	http500Threshold = 500
+)
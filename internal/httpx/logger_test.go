@@ -0,0 +1,44 @@
+package httpx
+
+import (
+	"bytes"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestLoggerEmitsExpectedFields(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, nil))
+
+	router := gin.New()
+	router.Use(RequestID(), Logger(logger))
+	router.GET("/x", func(c *gin.Context) { c.Status(http.StatusTeapot) })
+
+	req := httptest.NewRequest(http.MethodGet, "/x", nil)
+	req.Header.Set("User-Agent", "test-agent")
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("log line is not valid JSON: %v\n%s", err, buf.String())
+	}
+
+	for _, field := range []string{"method", "path", "status", "latency_ms", "client_ip", "user_agent", "request_id", "error"} {
+		if _, ok := entry[field]; !ok {
+			t.Errorf("log line missing field %q: %v", field, entry)
+		}
+	}
+	if entry["status"].(float64) != http.StatusTeapot {
+		t.Errorf("status = %v, want %d", entry["status"], http.StatusTeapot)
+	}
+	if entry["user_agent"] != "test-agent" {
+		t.Errorf("user_agent = %v, want test-agent", entry["user_agent"])
+	}
+}
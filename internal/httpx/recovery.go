@@ -0,0 +1,61 @@
+package httpx
+
+import (
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"runtime/debug"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// Recovery catches panics from downstream handlers, logs them via logger,
+// and returns a 500 JSON body. Broken-pipe/connection-reset errors (the
+// client went away mid-response) are logged at warn without writing a
+// response, since the connection is already gone.
+func Recovery(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		defer func() {
+			r := recover()
+			if r == nil {
+				return
+			}
+
+			if err, ok := r.(error); ok && isBrokenConnection(err) {
+				logger.Warn("connection closed by client",
+					slog.String("request_id", RequestIDFrom(c)),
+					slog.Any("error", err),
+				)
+				c.Abort()
+				return
+			}
+
+			logger.Error("panic recovered",
+				slog.String("request_id", RequestIDFrom(c)),
+				slog.Any("error", r),
+				slog.String("stack", string(debug.Stack())),
+			)
+			c.AbortWithStatusJSON(http.StatusInternalServerError, gin.H{"error": "internal server error"})
+		}()
+		c.Next()
+	}
+}
+
+// isBrokenConnection reports whether err is a broken-pipe or
+// connection-reset error surfaced through a net.OpError/os.SyscallError
+// chain.
+func isBrokenConnection(err error) bool {
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		return false
+	}
+	var sysErr *os.SyscallError
+	if !errors.As(opErr.Err, &sysErr) {
+		return false
+	}
+	msg := strings.ToLower(sysErr.Error())
+	return strings.Contains(msg, "broken pipe") || strings.Contains(msg, "connection reset")
+}
@@ -0,0 +1,49 @@
+package httpx
+
+import (
+	"crypto/rand"
+	"fmt"
+
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader is the header checked on the way in and set on the way
+// out.
+const RequestIDHeader = "X-Request-ID"
+
+// requestIDKey is the Gin context key the request ID is stored under.
+const requestIDKey = "request_id"
+
+// RequestID honors an incoming X-Request-ID, or generates a UUIDv4, and
+// makes it available to downstream middleware/handlers via
+// RequestIDFrom.
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if id == "" {
+			id = newUUIDv4()
+		}
+		c.Set(requestIDKey, id)
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+// RequestIDFrom returns the request ID stashed by RequestID, or "" if
+// the middleware wasn't installed.
+func RequestIDFrom(c *gin.Context) string {
+	id, _ := c.Get(requestIDKey)
+	s, _ := id.(string)
+	return s
+}
+
+// newUUIDv4 generates a random RFC 4122 version 4 UUID.
+func newUUIDv4() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		return "00000000-0000-4000-8000-000000000000"
+	}
+	b[6] = (b[6] & 0x0f) | 0x40
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
@@ -0,0 +1,45 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestIDGeneratesWhenMissing(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/x", func(c *gin.Context) {
+		c.String(http.StatusOK, RequestIDFrom(c))
+	})
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/x", nil))
+
+	header := rec.Header().Get(RequestIDHeader)
+	if header == "" {
+		t.Fatal("expected a generated request ID header")
+	}
+	if rec.Body.String() != header {
+		t.Errorf("handler saw request id %q, header has %q", rec.Body.String(), header)
+	}
+}
+
+func TestRequestIDHonorsIncoming(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+	router := gin.New()
+	router.Use(RequestID())
+	router.GET("/x", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/x", nil)
+	req.Header.Set(RequestIDHeader, "client-supplied-id")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get(RequestIDHeader); got != "client-supplied-id" {
+		t.Errorf("request id = %q, want %q", got, "client-supplied-id")
+	}
+}
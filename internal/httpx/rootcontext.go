@@ -0,0 +1,27 @@
+package httpx
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+)
+
+// WithRootContext derives each request's context from both rootCtx and
+// the request's own context, so either one cancelling unblocks a handler
+// selecting on ctx.Done(): rootCtx on graceful shutdown, the request's
+// own context the moment the client disconnects mid-stream. Replacing
+// the request context outright (dropping the client-disconnect signal)
+// would leave long-running SSE handlers writing into a dead connection
+// until the whole server shuts down.
+func WithRootContext(rootCtx context.Context) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx, cancel := context.WithCancel(c.Request.Context())
+		defer cancel()
+
+		stop := context.AfterFunc(rootCtx, cancel)
+		defer stop()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
@@ -0,0 +1,64 @@
+package httpx
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestWithRootContextCancelsOnClientDisconnect(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	reqCtx, reqCancel := context.WithCancel(context.Background())
+	rootCtx := context.Background() // never cancelled: server is not shutting down
+
+	router := gin.New()
+	router.Use(WithRootContext(rootCtx))
+	done := make(chan struct{})
+	router.GET("/x", func(c *gin.Context) {
+		<-c.Request.Context().Done()
+		close(done)
+		c.Status(http.StatusOK)
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/x", nil).WithContext(reqCtx)
+	reqCancel() // simulate the client disconnecting mid-request
+
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler's context was not cancelled when the request's own context was")
+	}
+}
+
+func TestWithRootContextCancelsOnShutdown(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	rootCtx, rootCancel := context.WithCancel(context.Background())
+
+	router := gin.New()
+	router.Use(WithRootContext(rootCtx))
+	done := make(chan struct{})
+	router.GET("/x", func(c *gin.Context) {
+		<-c.Request.Context().Done()
+		close(done)
+		c.Status(http.StatusOK)
+	})
+
+	go rootCancel()
+
+	req := httptest.NewRequest(http.MethodGet, "/x", nil)
+	router.ServeHTTP(httptest.NewRecorder(), req)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("handler's context was not cancelled when rootCtx was")
+	}
+}
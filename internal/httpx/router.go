@@ -0,0 +1,65 @@
+// Package httpx assembles the Gin middleware stack shared by every route:
+// structured logging, panic recovery, CORS, gzip, and request IDs.
+package httpx
+
+import (
+	"github.com/gin-contrib/cors"
+	"github.com/gin-contrib/gzip"
+	"github.com/gin-gonic/gin"
+)
+
+// Options configures BuildRouter. It will grow into (or be replaced by)
+// the typed config package once one exists; for now it's populated
+// straight from env by the caller.
+type Options struct {
+	// Mode is "debug" or "release"; anything else is treated as "debug".
+	Mode string
+	// LogLevel is one of debug/info/warn/error.
+	LogLevel string
+	// CORSAllowedOrigins is the allow-list passed to gin-contrib/cors.
+	CORSAllowedOrigins []string
+}
+
+// noGzipPaths are excluded from compression: /ping is trivial, and SSE
+// responses must not be buffered by the gzip writer.
+var noGzipPaths = []string{"/ping", "/ai"}
+
+// BuildRouter returns a ready-to-use *gin.Engine with the production
+// middleware stack installed, in the order: request ID, structured
+// logging, recovery, gzip, CORS.
+func BuildRouter(opts Options) *gin.Engine {
+	if opts.Mode == "release" {
+		gin.SetMode(gin.ReleaseMode)
+	} else {
+		gin.SetMode(gin.DebugMode)
+	}
+
+	logger := NewLogger(opts.LogLevel)
+
+	router := gin.New()
+	router.Use(
+		RequestID(),
+		Logger(logger),
+		Recovery(logger),
+		gzip.Gzip(gzip.DefaultCompression, gzip.WithExcludedPaths(noGzipPaths)),
+	)
+	// Fail closed: without an explicit allow-list (the checked-in
+	// default), mount no CORS middleware at all, so browsers are left to
+	// their default same-origin policy. gin-contrib/cors.New also
+	// panics if neither AllowOrigins nor AllowAllOrigins is set, so this
+	// both avoids that panic and avoids defaulting an unconfigured
+	// service open to every origin.
+	if len(opts.CORSAllowedOrigins) > 0 {
+		router.Use(cors.New(corsConfig(opts.CORSAllowedOrigins)))
+	}
+	return router
+}
+
+// corsConfig builds the cors.Config for a non-empty allowedOrigins.
+func corsConfig(allowedOrigins []string) cors.Config {
+	cfg := cors.DefaultConfig()
+	cfg.AllowOrigins = allowedOrigins
+	cfg.AllowMethods = []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"}
+	cfg.AllowHeaders = []string{"Authorization", "Content-Type", "X-Request-ID"}
+	return cfg
+}
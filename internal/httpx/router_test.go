@@ -0,0 +1,49 @@
+package httpx
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestBuildRouterDoesNotPanicWithDefaultOptions(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := BuildRouter(Options{Mode: "debug", LogLevel: "info"})
+	router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/ping", nil))
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("status = %d, want 200", rec.Code)
+	}
+}
+
+func TestBuildRouterMountsNoCORSMiddlewareWhenUnset(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	router := BuildRouter(Options{Mode: "debug", LogLevel: "info"})
+	router.GET("/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	req := httptest.NewRequest(http.MethodGet, "/ping", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if got := rec.Header().Get("Access-Control-Allow-Origin"); got != "" {
+		t.Errorf("Access-Control-Allow-Origin = %q, want unset (fail closed) when no allow-list is configured", got)
+	}
+}
+
+func TestCorsConfigUsesAllowListWhenSet(t *testing.T) {
+	cfg := corsConfig([]string{"https://example.com"})
+	if cfg.AllowAllOrigins {
+		t.Error("expected AllowAllOrigins to be false when an allow-list is configured")
+	}
+	if len(cfg.AllowOrigins) != 1 || cfg.AllowOrigins[0] != "https://example.com" {
+		t.Errorf("AllowOrigins = %v, want [https://example.com]", cfg.AllowOrigins)
+	}
+}
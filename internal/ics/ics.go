@@ -0,0 +1,82 @@
+// Package ics renders calendar events as RFC 5545 iCalendar text.
+package ics
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+const dateTimeLayout = "20060102T150405Z"
+
+// Event is a single VEVENT's worth of data.
+type Event struct {
+	UID         string
+	Summary     string
+	Description string
+	Location    string
+	Start       time.Time
+	End         time.Time
+	Attendees   []string
+}
+
+// NewUID deterministically derives a VEVENT UID from a seed (e.g. the
+// thread ID plus the suggestion's index), so re-rendering the same
+// suggestion twice produces the same UID.
+func NewUID(seed string) string {
+	sum := sha1.Sum([]byte(seed))
+	return hex.EncodeToString(sum[:]) + "@goinfomaniakhackaton2025"
+}
+
+// BuildCalendar renders events as a complete VCALENDAR document using
+// CRLF line endings, as required by RFC 5545.
+func BuildCalendar(events []Event) string {
+	var b strings.Builder
+	writeLine(&b, "BEGIN:VCALENDAR")
+	writeLine(&b, "VERSION:2.0")
+	writeLine(&b, "PRODID:-//GoInfomaniakHackaton2025//event_suggestion//EN")
+	writeLine(&b, "CALSCALE:GREGORIAN")
+
+	for _, e := range events {
+		writeLine(&b, "BEGIN:VEVENT")
+		writeLine(&b, "UID:"+e.UID)
+		writeLine(&b, "DTSTAMP:"+time.Now().UTC().Format(dateTimeLayout))
+		writeLine(&b, "DTSTART:"+e.Start.UTC().Format(dateTimeLayout))
+		writeLine(&b, "DTEND:"+e.End.UTC().Format(dateTimeLayout))
+		writeLine(&b, "SUMMARY:"+escape(e.Summary))
+		if e.Description != "" {
+			writeLine(&b, "DESCRIPTION:"+escape(e.Description))
+		}
+		if e.Location != "" {
+			writeLine(&b, "LOCATION:"+escape(e.Location))
+		}
+		for _, attendee := range e.Attendees {
+			writeLine(&b, "ATTENDEE:mailto:"+attendee)
+		}
+		writeLine(&b, "END:VEVENT")
+	}
+
+	writeLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+func writeLine(b *strings.Builder, line string) {
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
+
+var escaper = strings.NewReplacer(
+	`\`, `\\`,
+	`;`, `\;`,
+	`,`, `\,`,
+	"\n", `\n`,
+)
+
+// escape applies RFC 5545 TEXT escaping.
+func escape(s string) string {
+	return escaper.Replace(s)
+}
+
+// ContentType is the MIME type to use when serving a calendar document.
+const ContentType = "text/calendar; charset=utf-8"
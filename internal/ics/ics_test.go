@@ -0,0 +1,84 @@
+package ics
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestBuildCalendar(t *testing.T) {
+	start := time.Date(2026, 1, 2, 10, 0, 0, 0, time.UTC)
+	end := start.Add(30 * time.Minute)
+
+	tests := []struct {
+		name   string
+		events []Event
+		want   []string
+		absent []string
+	}{
+		{
+			name: "single event with all fields",
+			events: []Event{
+				{
+					UID:         NewUID("thread-1:0"),
+					Summary:     "Lunch; team, sync",
+					Description: "Discuss roadmap\nagenda attached",
+					Location:    "Room A",
+					Start:       start,
+					End:         end,
+					Attendees:   []string{"alice@example.com", "bob@example.com"},
+				},
+			},
+			want: []string{
+				"BEGIN:VCALENDAR",
+				"BEGIN:VEVENT",
+				"DTSTART:20260102T100000Z",
+				"DTEND:20260102T103000Z",
+				`SUMMARY:Lunch\; team\, sync`,
+				`DESCRIPTION:Discuss roadmap\nagenda attached`,
+				"LOCATION:Room A",
+				"ATTENDEE:mailto:alice@example.com",
+				"ATTENDEE:mailto:bob@example.com",
+				"END:VEVENT",
+				"END:VCALENDAR",
+			},
+		},
+		{
+			name:   "no events still yields a valid empty calendar",
+			events: nil,
+			want:   []string{"BEGIN:VCALENDAR", "END:VCALENDAR"},
+			absent: []string{"BEGIN:VEVENT"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := BuildCalendar(tt.events)
+			if !strings.Contains(got, "\r\n") {
+				t.Errorf("expected CRLF line endings")
+			}
+			for _, want := range tt.want {
+				if !strings.Contains(got, want) {
+					t.Errorf("output missing %q\nfull output:\n%s", want, got)
+				}
+			}
+			for _, absent := range tt.absent {
+				if strings.Contains(got, absent) {
+					t.Errorf("output unexpectedly contains %q", absent)
+				}
+			}
+		})
+	}
+}
+
+func TestNewUIDIsDeterministic(t *testing.T) {
+	a := NewUID("thread-1:0")
+	b := NewUID("thread-1:0")
+	c := NewUID("thread-1:1")
+	if a != b {
+		t.Errorf("expected same seed to produce same UID: %q != %q", a, b)
+	}
+	if a == c {
+		t.Errorf("expected different seeds to produce different UIDs")
+	}
+}
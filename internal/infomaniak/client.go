@@ -0,0 +1,79 @@
+// Package infomaniak is a thin client for the Infomaniak Mail API, scoped
+// to what the event-suggestion pipeline needs: fetching a thread's
+// messages on behalf of the authenticated user.
+package infomaniak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// DefaultBaseURL is used when no base URL is configured.
+const DefaultBaseURL = "https://api.infomaniak.com"
+
+// Client talks to the Infomaniak Mail API.
+type Client struct {
+	BaseURL    string
+	HTTPClient *http.Client
+}
+
+// NewClient builds a Client. A nil httpClient gets a sane default timeout.
+func NewClient(baseURL string, httpClient *http.Client) *Client {
+	if baseURL == "" {
+		baseURL = DefaultBaseURL
+	}
+	if httpClient == nil {
+		httpClient = &http.Client{Timeout: 15 * time.Second}
+	}
+	return &Client{
+		BaseURL:    strings.TrimRight(baseURL, "/"),
+		HTTPClient: httpClient,
+	}
+}
+
+// envelope mirrors Infomaniak's common "result"/"data" API wrapper.
+type envelope struct {
+	Result string `json:"result"`
+	Data   Thread `json:"data"`
+}
+
+// FetchThread fetches a thread's messages on behalf of the user owning
+// token.
+func (c *Client) FetchThread(ctx context.Context, token, mailboxUUID, folderID, threadID string) (*Thread, error) {
+	reqURL := fmt.Sprintf("%s/api/mail/%s/folder/%s/thread/%s", c.BaseURL,
+		url.PathEscape(mailboxUUID), url.PathEscape(folderID), url.PathEscape(threadID))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, reqURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("infomaniak: build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("infomaniak: fetch thread: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("infomaniak: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return nil, fmt.Errorf("infomaniak: decode response: %w", err)
+	}
+	if env.Result != "" && env.Result != "success" {
+		return nil, fmt.Errorf("infomaniak: api returned result %q", env.Result)
+	}
+
+	return &env.Data, nil
+}
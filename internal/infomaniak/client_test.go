@@ -0,0 +1,129 @@
+package infomaniak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestFetchThread(t *testing.T) {
+	tests := []struct {
+		name       string
+		status     int
+		body       string
+		wantErr    bool
+		wantSubj   string
+		wantPlain  string
+		checkToken bool
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body: `{"result":"success","data":{"id":"t1","subject":"Lunch?","messages":[
+				{"id":"m1","from":{"name":"Alice","email":"alice@example.com"},"subject":"Lunch?","date":"2026-01-02T10:00:00Z","body":{"text/plain":"Lunch at noon?"}}
+			]}}`,
+			wantSubj:   "Lunch?",
+			wantPlain:  "Lunch at noon?",
+			checkToken: true,
+		},
+		{
+			name:    "api error result",
+			status:  http.StatusOK,
+			body:    `{"result":"error","data":{}}`,
+			wantErr: true,
+		},
+		{
+			name:    "upstream 500",
+			status:  http.StatusInternalServerError,
+			body:    `{"error":"boom"}`,
+			wantErr: true,
+		},
+		{
+			name:    "invalid json",
+			status:  http.StatusOK,
+			body:    `not json`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.checkToken && r.Header.Get("Authorization") != "Bearer sekret" {
+					t.Errorf("missing/incorrect Authorization header: %q", r.Header.Get("Authorization"))
+				}
+				if !strings.HasSuffix(r.URL.Path, "/api/mail/mbx/folder/f1/thread/th1") {
+					t.Errorf("unexpected path: %s", r.URL.Path)
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			c := NewClient(srv.URL, nil)
+			thread, err := c.FetchThread(context.Background(), "sekret", "mbx", "f1", "th1")
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatalf("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if thread.Subject != tt.wantSubj {
+				t.Errorf("subject = %q, want %q", thread.Subject, tt.wantSubj)
+			}
+			if got := thread.Messages[0].Body.plainText(); got != tt.wantPlain {
+				t.Errorf("plain text = %q, want %q", got, tt.wantPlain)
+			}
+		})
+	}
+}
+
+func TestFetchThreadEscapesPathSegments(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasSuffix(r.URL.EscapedPath(), "/api/mail/mbx%2Fid/folder/f1/thread/th1") {
+			t.Errorf("unexpected path: %s", r.URL.EscapedPath())
+		}
+		w.Write([]byte(`{"result":"success","data":{}}`))
+	}))
+	defer srv.Close()
+
+	c := NewClient(srv.URL, nil)
+	if _, err := c.FetchThread(context.Background(), "sekret", "mbx/id", "f1", "th1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestTranscriptPrefersPlainAndStripsHTML(t *testing.T) {
+	thread := &Thread{
+		Subject: "Planning",
+		Messages: []Message{
+			{
+				From:    Address{Name: "Bob", Email: "bob@example.com"},
+				Subject: "Planning",
+				Body:    Body{Plain: "See you at 3pm."},
+			},
+			{
+				From:    Address{Name: "Carol", Email: "carol@example.com"},
+				Subject: "Re: Planning",
+				Body:    Body{HTML: "<p>Works for <b>me</b> &amp; team.</p>"},
+			},
+		},
+	}
+
+	got := thread.Transcript()
+	if !strings.Contains(got, "See you at 3pm.") {
+		t.Errorf("transcript missing plain body: %q", got)
+	}
+	if !strings.Contains(got, "Works for me & team.") {
+		t.Errorf("transcript did not strip/unescape html body: %q", got)
+	}
+	if strings.Contains(got, "<p>") || strings.Contains(got, "<b>") {
+		t.Errorf("transcript leaked html tags: %q", got)
+	}
+}
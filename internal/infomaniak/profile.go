@@ -0,0 +1,63 @@
+package infomaniak
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// Profile is the authenticated user's identity, as returned by
+// Infomaniak's /profile endpoint.
+type Profile struct {
+	ID           string   `json:"id"`
+	Email        string   `json:"email"`
+	MailboxUUIDs []string `json:"mailbox_uuids"`
+}
+
+// OwnsMailbox reports whether mailboxUUID belongs to this profile.
+func (p *Profile) OwnsMailbox(mailboxUUID string) bool {
+	for _, uuid := range p.MailboxUUIDs {
+		if uuid == mailboxUUID {
+			return true
+		}
+	}
+	return false
+}
+
+// FetchProfile resolves the identity behind token, for authorization
+// checks ahead of mailbox-scoped calls.
+func (c *Client) FetchProfile(ctx context.Context, token string) (*Profile, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.BaseURL+"/profile", nil)
+	if err != nil {
+		return nil, fmt.Errorf("infomaniak: build request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("infomaniak: fetch profile: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		return nil, fmt.Errorf("infomaniak: unexpected status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	var env struct {
+		Result string  `json:"result"`
+		Data   Profile `json:"data"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return nil, fmt.Errorf("infomaniak: decode response: %w", err)
+	}
+	if env.Result != "" && env.Result != "success" {
+		return nil, fmt.Errorf("infomaniak: api returned result %q", env.Result)
+	}
+
+	return &env.Data, nil
+}
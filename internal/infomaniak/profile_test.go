@@ -0,0 +1,70 @@
+package infomaniak
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestFetchProfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  int
+		body    string
+		wantErr bool
+		wantID  string
+	}{
+		{
+			name:   "success",
+			status: http.StatusOK,
+			body:   `{"result":"success","data":{"id":"u1","email":"alice@example.com","mailbox_uuids":["mbx-1","mbx-2"]}}`,
+			wantID: "u1",
+		},
+		{
+			name:    "upstream error",
+			status:  http.StatusUnauthorized,
+			body:    `{"error":"invalid token"}`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer tok" {
+					t.Errorf("missing/incorrect Authorization header: %q", r.Header.Get("Authorization"))
+				}
+				w.WriteHeader(tt.status)
+				w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+
+			c := NewClient(srv.URL, nil)
+			profile, err := c.FetchProfile(context.Background(), "tok")
+
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if profile.ID != tt.wantID {
+				t.Errorf("ID = %q, want %q", profile.ID, tt.wantID)
+			}
+		})
+	}
+}
+
+func TestProfileOwnsMailbox(t *testing.T) {
+	p := &Profile{MailboxUUIDs: []string{"a", "b"}}
+	if !p.OwnsMailbox("a") {
+		t.Error("expected OwnsMailbox(a) to be true")
+	}
+	if p.OwnsMailbox("z") {
+		t.Error("expected OwnsMailbox(z) to be false")
+	}
+}
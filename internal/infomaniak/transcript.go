@@ -0,0 +1,44 @@
+package infomaniak
+
+import (
+	"fmt"
+	"html"
+	"regexp"
+	"strings"
+)
+
+var htmlTagRE = regexp.MustCompile(`(?s)<[^>]*>`)
+
+// stripHTML reduces an HTML body to plain text: tags are dropped, entities
+// are unescaped, and runs of whitespace are collapsed.
+func stripHTML(s string) string {
+	withoutTags := htmlTagRE.ReplaceAllString(s, " ")
+	unescaped := html.UnescapeString(withoutTags)
+	return strings.Join(strings.Fields(unescaped), " ")
+}
+
+// plainText returns the best-effort plain text rendering of a message
+// body, preferring text/plain and falling back to a stripped text/html.
+func (b Body) plainText() string {
+	if strings.TrimSpace(b.Plain) != "" {
+		return strings.TrimSpace(b.Plain)
+	}
+	return stripHTML(b.HTML)
+}
+
+// Transcript normalizes a thread into a single plain-text document
+// suitable for feeding to an LLM: one block per message, oldest first.
+func (t *Thread) Transcript() string {
+	var sb strings.Builder
+	for i, m := range t.Messages {
+		if i > 0 {
+			sb.WriteString("\n---\n")
+		}
+		fmt.Fprintf(&sb, "From: %s <%s>\n", m.From.Name, m.From.Email)
+		fmt.Fprintf(&sb, "Date: %s\n", m.Date.Format("2006-01-02 15:04"))
+		fmt.Fprintf(&sb, "Subject: %s\n\n", m.Subject)
+		sb.WriteString(m.Body.plainText())
+		sb.WriteString("\n")
+	}
+	return sb.String()
+}
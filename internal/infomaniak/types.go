@@ -0,0 +1,36 @@
+package infomaniak
+
+import "time"
+
+// Address is a named mailbox participant (sender, recipient, ...).
+type Address struct {
+	Name  string `json:"name"`
+	Email string `json:"email"`
+}
+
+// Body holds the MIME bodies of a message, keyed by content type. Either
+// field may be empty depending on what the upstream message actually
+// contains.
+type Body struct {
+	Plain string `json:"text/plain"`
+	HTML  string `json:"text/html"`
+}
+
+// Message is a single email in a thread, as returned by the Infomaniak
+// Mail API.
+type Message struct {
+	ID      string    `json:"id"`
+	From    Address   `json:"from"`
+	To      []Address `json:"to"`
+	Subject string    `json:"subject"`
+	Date    time.Time `json:"date"`
+	Body    Body      `json:"body"`
+}
+
+// Thread is a mail conversation: an ordered list of messages sharing a
+// subject.
+type Thread struct {
+	ID       string    `json:"id"`
+	Subject  string    `json:"subject"`
+	Messages []Message `json:"messages"`
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/ai"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/auth"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/ics"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/infomaniak"
+)
+
+// MailHandler serves the event-suggestion pipeline: Infomaniak Mail ->
+// LLM -> JSON or iCalendar.
+type MailHandler struct {
+	Infomaniak *infomaniak.Client
+	AI         ai.Client
+}
+
+// NewMailHandler builds a MailHandler from its upstream clients.
+func NewMailHandler(infomaniakClient *infomaniak.Client, aiClient ai.Client) *MailHandler {
+	return &MailHandler{Infomaniak: infomaniakClient, AI: aiClient}
+}
+
+// EventSuggestion handles POST .../thread/:thread_id/event_suggestion.
+// It returns JSON by default, or an iCalendar document when the caller
+// sends `Accept: text/calendar`. It expects auth.Middleware to have
+// already populated the caller's token.
+func (h *MailHandler) EventSuggestion(c *gin.Context) {
+	token := auth.TokenFrom(c)
+
+	mailboxUUID := c.Param("mailbox_uuid")
+	folderID := c.Param("folder_id")
+	threadID := c.Param("thread_id")
+
+	thread, err := h.Infomaniak.FetchThread(c.Request.Context(), token, mailboxUUID, folderID, threadID)
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	suggestions, err := h.AI.SuggestEvents(c.Request.Context(), thread.Transcript())
+	if err != nil {
+		c.JSON(http.StatusBadGateway, gin.H{"error": err.Error()})
+		return
+	}
+
+	if strings.Contains(c.GetHeader("Accept"), "text/calendar") {
+		events, err := toICSEvents(threadID, suggestions)
+		if err != nil {
+			c.JSON(http.StatusUnprocessableEntity, gin.H{"error": err.Error()})
+			return
+		}
+		c.Header("Content-Type", ics.ContentType)
+		c.String(http.StatusOK, ics.BuildCalendar(events))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"suggestions": suggestions})
+}
+
+// toICSEvents converts the LLM's event suggestions into renderable ICS
+// events, parsing the RFC 3339 timestamps the model was asked to produce.
+func toICSEvents(threadID string, suggestions []ai.EventSuggestion) ([]ics.Event, error) {
+	events := make([]ics.Event, 0, len(suggestions))
+	for i, s := range suggestions {
+		start, err := time.Parse(time.RFC3339, s.Start)
+		if err != nil {
+			return nil, fmt.Errorf("suggestion %d: invalid start %q: %w", i, s.Start, err)
+		}
+		end, err := time.Parse(time.RFC3339, s.End)
+		if err != nil {
+			return nil, fmt.Errorf("suggestion %d: invalid end %q: %w", i, s.End, err)
+		}
+		events = append(events, ics.Event{
+			UID:         ics.NewUID(fmt.Sprintf("%s:%d", threadID, i)),
+			Summary:     s.Title,
+			Description: s.Description,
+			Location:    s.Location,
+			Start:       start,
+			End:         end,
+			Attendees:   s.Attendees,
+		})
+	}
+	return events, nil
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/ai"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/auth"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/infomaniak"
+)
+
+func TestEventSuggestionHandler(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	mailSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"result":"success","data":{"id":"th1","subject":"Sync","messages":[
+			{"from":{"name":"Alice","email":"alice@example.com"},"subject":"Sync","body":{"text/plain":"Let's meet tomorrow at 10."}}
+		]}}`))
+	}))
+	defer mailSrv.Close()
+
+	aiSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"choices":[{"message":{"role":"assistant","content":"[{\"title\":\"Sync\",\"start\":\"2026-01-02T10:00:00Z\",\"end\":\"2026-01-02T10:30:00Z\"}]"}}]}`))
+	}))
+	defer aiSrv.Close()
+
+	handler := NewMailHandler(
+		infomaniak.NewClient(mailSrv.URL, nil),
+		ai.NewClient(ai.Config{BaseURL: aiSrv.URL, APIKey: "k"}, nil),
+	)
+
+	router := gin.New()
+	router.Use(auth.Middleware())
+	router.POST("/mail/:mailbox_uuid/folder/:folder_id/thread/:thread_id/event_suggestion", handler.EventSuggestion)
+
+	tests := []struct {
+		name       string
+		accept     string
+		authHeader string
+		wantStatus int
+		wantBody   string
+	}{
+		{
+			name:       "json by default",
+			authHeader: "Bearer tok",
+			wantStatus: http.StatusOK,
+			wantBody:   `"title":"Sync"`,
+		},
+		{
+			name:       "ics when requested",
+			accept:     "text/calendar",
+			authHeader: "Bearer tok",
+			wantStatus: http.StatusOK,
+			wantBody:   "BEGIN:VEVENT",
+		},
+		{
+			name:       "missing bearer token",
+			wantStatus: http.StatusUnauthorized,
+			wantBody:   "missing bearer token",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/mail/mbx/folder/f1/thread/th1/event_suggestion", nil)
+			if tt.authHeader != "" {
+				req.Header.Set("Authorization", tt.authHeader)
+			}
+			if tt.accept != "" {
+				req.Header.Set("Accept", tt.accept)
+			}
+			rec := httptest.NewRecorder()
+			router.ServeHTTP(rec, req)
+
+			if rec.Code != tt.wantStatus {
+				t.Fatalf("status = %d, want %d (body: %s)", rec.Code, tt.wantStatus, rec.Body.String())
+			}
+			if !strings.Contains(rec.Body.String(), tt.wantBody) {
+				t.Errorf("body = %q, want it to contain %q", rec.Body.String(), tt.wantBody)
+			}
+		})
+	}
+}
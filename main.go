@@ -1,62 +1,109 @@
 package main
 
 import (
+	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/gin-gonic/gin"
-	"github.com/joho/godotenv"
-)
 
-func loadEnv() {
-	err := godotenv.Load(".env")
-	if err != nil {
-		if os.IsNotExist(err) {
-			log.Println("⚠️  Fichier .env non trouvé, utilisation des variables d'environnement système")
-		} else {
-			log.Printf("⚠️  Impossible de charger .env : %v", err)
-		}
-	}
-}
-
-func getEnvWithDefault(key, defaultValue string) string {
-	if value, exists := os.LookupEnv(key); exists {
-		return value
-	}
-	return defaultValue
-}
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/ai"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/auth"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/config"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/httpx"
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/infomaniak"
+)
 
 func ping(c *gin.Context) {
 	c.Header("Content-Type", "text/plain; charset=utf-8")
 	c.String(http.StatusOK, "Pong")
 }
 
-func mail(c *gin.Context) {
-	// mailboxUUID := c.Param("mailbox_uuid")
-	// folderID := c.Param("folder_id")
-	// threadID := c.Param("thread_id")
+// newRouter builds the fully wired *gin.Engine: middleware stack, every
+// route, and a root-context middleware so in-flight handlers observe
+// ctx's cancellation.
+func newRouter(ctx context.Context, cfg *config.Config) *gin.Engine {
+	router := httpx.BuildRouter(httpx.Options{
+		Mode:               cfg.Mode,
+		LogLevel:           cfg.Log.Level,
+		CORSAllowedOrigins: cfg.CORS.AllowedOrigins,
+	})
+	router.Use(httpx.WithRootContext(ctx))
 
-	// var input YourInputModel
-	// if err := c.ShouldBindJSON(&input); err != nil {
-	//     c.JSON(http.StatusBadRequest, gin.H{"error": "Requête invalide"})
-	//     return
-	// }
-}
+	infomaniakClient := infomaniak.NewClient(cfg.Infomaniak.BaseURL, &http.Client{Timeout: cfg.Infomaniak.Timeout})
+	aiClient := ai.NewClient(ai.Config{
+		BaseURL: cfg.AI.BaseURL,
+		Model:   cfg.AI.Model,
+		APIKey:  cfg.AI.APIKey,
+		Timeout: cfg.AI.Timeout,
+	}, &http.Client{Timeout: cfg.AI.Timeout})
+	mailHandler := NewMailHandler(infomaniakClient, aiClient)
+	aiHandler := NewAIHandler(aiClient)
 
-func ai(c *gin.Context) {
+	router.GET("/ping", ping)
+	router.POST("/ai", aiHandler.Chat)
 
+	mailRoutes := router.Group("/mail/:mailbox_uuid/folder/:folder_id/thread/:thread_id")
+	mailRoutes.Use(auth.Middleware())
+	if cfg.Auth.IntrospectionEnabled {
+		verifier := auth.NewMailboxVerifier(infomaniakClient, cfg.Auth.IntrospectionCacheSize, cfg.Auth.IntrospectionCacheTTL)
+		mailRoutes.Use(verifier.VerifyMailboxOwner())
+	}
+	mailRoutes.POST("/event_suggestion", mailHandler.EventSuggestion)
+
+	return router
 }
-func main() {
-	loadEnv()
 
-	gin.SetMode(gin.DebugMode)
+// serve runs the HTTP server on ln until ctx is cancelled, then drains
+// in-flight requests for up to cfg.HTTP.ShutdownTimeout before returning.
+func serve(ctx context.Context, cfg *config.Config, ln net.Listener) error {
+	srv := &http.Server{
+		Handler: newRouter(ctx, cfg),
+		// No WriteTimeout: see the HTTP.ReadHeaderTimeout doc comment in
+		// internal/config for why a blanket write deadline would break
+		// streaming and slow mail-thread responses.
+		ReadTimeout:       cfg.HTTP.ReadTimeout,
+		ReadHeaderTimeout: cfg.HTTP.ReadHeaderTimeout,
+		IdleTimeout:       cfg.HTTP.IdleTimeout,
+	}
 
-	router := gin.Default()
+	serveErr := make(chan error, 1)
+	go func() { serveErr <- srv.Serve(ln) }()
 
-	router.GET("/ping", ping)
+	select {
+	case err := <-serveErr:
+		return err
+	case <-ctx.Done():
+	}
 
-	router.POST("/mail/:mailbox_uuid/folder/:folder_id/thread/:thread_id/event_suggestion", mail)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.HTTP.ShutdownTimeout)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil {
+		return err
+	}
+	return <-serveErr
+}
 
-	router.Run("localhost:8080")
+func main() {
+	cfg, err := config.Load()
+	if err != nil {
+		log.Fatalf("config: %v", err)
+	}
+
+	ln, err := net.Listen("tcp", cfg.HTTP.Addr())
+	if err != nil {
+		log.Fatalf("listen: %v", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	log.Printf("listening on %s", cfg.HTTP.Addr())
+	if err := serve(ctx, cfg, ln); err != nil && err != http.ErrServerClosed {
+		log.Fatalf("server: %v", err)
+	}
 }
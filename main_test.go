@@ -0,0 +1,111 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mug4z/GoInfomaniakHackaton2025/internal/config"
+)
+
+// slowAIStream serves an OpenAI-compatible SSE chat completion that
+// drips tokens slowly, so a test can reliably catch a request mid-stream.
+func slowAIStream(t *testing.T) *httptest.Server {
+	t.Helper()
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		flusher := w.(http.Flusher)
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.WriteHeader(http.StatusOK)
+		for i := 0; i < 50; i++ {
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(50 * time.Millisecond):
+			}
+			w.Write([]byte(`data: {"choices":[{"delta":{"content":"x"}}]}` + "\n\n"))
+			flusher.Flush()
+		}
+	}))
+}
+
+func testConfig(t *testing.T, aiBaseURL string) *config.Config {
+	t.Helper()
+	t.Setenv("AI_API_KEY", "test-key")
+	t.Setenv("AI_BASE_URL", aiBaseURL)
+
+	cfg, err := config.Load()
+	if err != nil {
+		t.Fatalf("config.Load: %v", err)
+	}
+	cfg.HTTP.ShutdownTimeout = 2 * time.Second
+	return cfg
+}
+
+// TestServeDrainsSSEStreamOnShutdown starts the real server on an
+// ephemeral port, opens an in-flight /ai SSE request against a slow fake
+// upstream, cancels the root context (simulating a shutdown signal)
+// while the stream is open, and asserts serve() returns within the
+// configured drain window instead of hanging on the open connection.
+func TestServeDrainsSSEStreamOnShutdown(t *testing.T) {
+	aiSrv := slowAIStream(t)
+	defer aiSrv.Close()
+	cfg := testConfig(t, aiSrv.URL)
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	serveDone := make(chan error, 1)
+	go func() { serveDone <- serve(ctx, cfg, ln) }()
+
+	conn, err := net.DialTimeout("tcp", ln.Addr().String(), 2*time.Second)
+	if err != nil {
+		t.Fatalf("dial: %v", err)
+	}
+	defer conn.Close()
+
+	body := `{"messages":[{"role":"user","content":"hi"}]}`
+	req, err := http.NewRequest(http.MethodPost, "http://"+ln.Addr().String()+"/ai", strings.NewReader(body))
+	if err != nil {
+		t.Fatalf("build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.ContentLength = int64(len(body))
+	if err := req.Write(conn); err != nil {
+		t.Fatalf("write request: %v", err)
+	}
+
+	resp, err := http.ReadResponse(bufio.NewReader(conn), req)
+	if err != nil {
+		t.Fatalf("read response: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("status = %d, want 200", resp.StatusCode)
+	}
+
+	// Read a handful of SSE bytes so we know the stream is genuinely open.
+	buf := make([]byte, 64)
+	if _, err := resp.Body.Read(buf); err != nil {
+		t.Fatalf("read stream: %v", err)
+	}
+	resp.Body.Close()
+
+	cancel() // simulate the shutdown signal firing mid-stream
+
+	select {
+	case err := <-serveDone:
+		if err != nil && err != http.ErrServerClosed {
+			t.Fatalf("serve returned %v", err)
+		}
+	case <-time.After(cfg.HTTP.ShutdownTimeout + 2*time.Second):
+		t.Fatal("serve did not return within the drain window")
+	}
+}